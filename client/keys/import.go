@@ -7,12 +7,15 @@ import (
 	"os"
 	"strings"
 
+	"github.com/cosmos/go-bip39"
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/input"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
 )
 
@@ -71,3 +74,152 @@ func ImportKeyHexCommand() *cobra.Command {
 	cmd.Flags().String(flags.FlagKeyType, string(hd.Secp256k1Type), "private key signing algorithm kind")
 	return cmd
 }
+
+// ImportMnemonicsCommand imports a batch of keys from a file of labeled mnemonics.
+func ImportMnemonicsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import-mnemonics <file>",
+		Short: "Import keys from a file of labeled mnemonics into the local keybase",
+		Long: `Import keys from a file of labeled bip39 mnemonics into the local keybase.
+Each non-empty line of the file holds a key name followed by its mnemonic, separated by whitespace.
+Lines starting with # are ignored. Keys whose name already exists in the keybase are skipped.
+All entries are validated before any key is imported, including that no two mnemonics derive
+the same address and that no mnemonic derives the address of an existing key.
+
+Example file:
+
+    # validators
+    val1 <24 word mnemonic>
+    val2 <24 word mnemonic>
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			entries, err := parseMnemonicsFile(string(bz))
+			if err != nil {
+				return err
+			}
+
+			kb := clientCtx.Keyring
+			keyringAlgos, _ := kb.SupportedAlgorithms()
+			algoStr, _ := cmd.Flags().GetString(flags.FlagKeyType)
+			algo, err := keyring.NewSigningAlgoFromString(algoStr, keyringAlgos)
+			if err != nil {
+				return err
+			}
+
+			coinType, _ := cmd.Flags().GetUint32(flagCoinType)
+			account, _ := cmd.Flags().GetUint32(flagAccount)
+			index, _ := cmd.Flags().GetUint32(flagIndex)
+			hdPath := hd.CreateHDPath(coinType, account, index).String()
+
+			// derive all addresses up front so that address collisions are
+			// reported before any key is written
+			var skipped []string
+			toImport := make([]mnemonicEntry, 0, len(entries))
+			addrs := make(map[string]string, len(entries))
+			for _, entry := range entries {
+				if _, err := kb.Key(entry.name); err == nil {
+					skipped = append(skipped, entry.name)
+					continue
+				}
+
+				derivedPriv, err := algo.Derive()(entry.mnemonic, "", hdPath)
+				if err != nil {
+					return fmt.Errorf("failed to derive key %s: %w", entry.name, err)
+				}
+
+				addr := algo.Generate()(derivedPriv).PubKey().Address()
+				if other, ok := addrs[string(addr)]; ok {
+					return fmt.Errorf("keys %s and %s derive the same address %s", other, entry.name, sdk.AccAddress(addr))
+				}
+
+				if k, err := kb.KeyByAddress(addr); err == nil {
+					return fmt.Errorf("key %s derives the address of existing key %s", entry.name, k.Name)
+				}
+
+				addrs[string(addr)] = entry.name
+				toImport = append(toImport, entry)
+			}
+
+			for _, name := range skipped {
+				cmd.Printf("skipped %s: key already exists\n", name)
+			}
+
+			for _, entry := range toImport {
+				k, err := kb.NewAccount(entry.name, entry.mnemonic, "", hdPath, algo)
+				if err != nil {
+					return fmt.Errorf("failed to import key %s: %w", entry.name, err)
+				}
+
+				addr, err := k.GetAddress()
+				if err != nil {
+					return err
+				}
+
+				cmd.Printf("imported %s: %s\n", entry.name, sdk.AccAddress(addr))
+			}
+
+			cmd.Printf("imported %d keys, skipped %d keys\n", len(toImport), len(skipped))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagKeyType, string(hd.Secp256k1Type), "Key signing algorithm to import keys for")
+	cmd.Flags().Uint32(flagCoinType, sdk.CoinType, "coin type number for HD derivation")
+	cmd.Flags().Uint32(flagAccount, 0, "Account number for HD derivation (less than equal 2147483647)")
+	cmd.Flags().Uint32(flagIndex, 0, "Address index number for HD derivation (less than equal 2147483647)")
+
+	return cmd
+}
+
+type mnemonicEntry struct {
+	name     string
+	mnemonic string
+}
+
+// parseMnemonicsFile parses the content of a labeled mnemonics file. Errors
+// only refer to line numbers and key names so mnemonics are never echoed back.
+func parseMnemonicsFile(content string) ([]mnemonicEntry, error) {
+	var entries []mnemonicEntry
+	names := make(map[string]struct{})
+
+	for i, line := range strings.Split(content, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			return nil, fmt.Errorf("line %d: expected a key name followed by a mnemonic", i+1)
+		}
+
+		name, mnemonic := fields[0], strings.Join(fields[1:], " ")
+		if _, ok := names[name]; ok {
+			return nil, fmt.Errorf("line %d: duplicate key name %s", i+1, name)
+		}
+
+		if !bip39.IsMnemonicValid(mnemonic) {
+			return nil, fmt.Errorf("line %d: invalid mnemonic for key %s", i+1, name)
+		}
+
+		names[name] = struct{}{}
+		entries = append(entries, mnemonicEntry{name: name, mnemonic: mnemonic})
+	}
+
+	if len(entries) == 0 {
+		return nil, errors.New("no mnemonics found in file")
+	}
+
+	return entries, nil
+}
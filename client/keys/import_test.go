@@ -1,6 +1,7 @@
 package keys
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"os"
@@ -12,6 +13,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -212,3 +214,102 @@ func Test_runImportCmdWithEmptyName(t *testing.T) {
 
 	require.ErrorContains(t, cmd.ExecuteContext(ctx), "the provided name is invalid or empty after trimming whitespace")
 }
+
+func Test_runImportMnemonicsCmd(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}).Codec
+	kb := keyring.NewInMemory(cdc)
+
+	mnemonics := make([]string, 3)
+	for i := range mnemonics {
+		_, mnemonic, err := kb.NewMnemonic(fmt.Sprintf("gen%d", i), keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+		require.NoError(t, err)
+		require.NoError(t, kb.Delete(fmt.Sprintf("gen%d", i)))
+		mnemonics[i] = mnemonic
+	}
+
+	_, err := kb.NewAccount("existing", mnemonics[2], "", sdk.FullFundraiserPath, hd.Secp256k1)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name     string
+		content  string
+		expErr   string
+		expKeys  []string
+		expNoKey []string
+	}{
+		{
+			name:     "invalid mnemonic imports nothing",
+			content:  fmt.Sprintf("key1 %s\nkey2 not a valid mnemonic\n", mnemonics[0]),
+			expErr:   "line 2: invalid mnemonic for key key2",
+			expNoKey: []string{"key1", "key2"},
+		},
+		{
+			name:     "duplicate name imports nothing",
+			content:  fmt.Sprintf("key1 %s\nkey1 %s\n", mnemonics[0], mnemonics[1]),
+			expErr:   "line 2: duplicate key name key1",
+			expNoKey: []string{"key1"},
+		},
+		{
+			name:    "empty file",
+			content: "# nothing to import\n",
+			expErr:  "no mnemonics found in file",
+		},
+		{
+			name:     "same mnemonic twice imports nothing",
+			content:  fmt.Sprintf("key1 %s\nkey2 %s\n", mnemonics[0], mnemonics[0]),
+			expErr:   "keys key1 and key2 derive the same address",
+			expNoKey: []string{"key1", "key2"},
+		},
+		{
+			name:     "mnemonic of existing key under another name imports nothing",
+			content:  fmt.Sprintf("key1 %s\nkey2 %s\n", mnemonics[0], mnemonics[2]),
+			expErr:   "key key2 derives the address of existing key existing",
+			expNoKey: []string{"key1", "key2"},
+		},
+		{
+			name:    "success skipping existing key",
+			content: fmt.Sprintf("# validators\nkey1 %s\n\nkey2 %s\nexisting %s\n", mnemonics[0], mnemonics[1], mnemonics[2]),
+			expKeys: []string{"key1", "key2", "existing"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cmd := ImportMnemonicsCommand()
+			cmd.Flags().AddFlagSet(Commands().PersistentFlags())
+			out := &bytes.Buffer{}
+			cmd.SetOut(out)
+
+			clientCtx := client.Context{}.
+				WithKeyring(kb).
+				WithCodec(cdc)
+			ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+
+			file := filepath.Join(t.TempDir(), "mnemonics.txt")
+			require.NoError(t, os.WriteFile(file, []byte(tc.content), 0o600))
+
+			cmd.SetArgs([]string{file})
+			err := cmd.ExecuteContext(ctx)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+				require.Contains(t, out.String(), "imported 2 keys, skipped 1 keys")
+			}
+
+			for _, mnemonic := range mnemonics {
+				require.NotContains(t, out.String(), mnemonic)
+			}
+
+			for _, name := range tc.expKeys {
+				_, err := kb.Key(name)
+				require.NoError(t, err)
+			}
+
+			for _, name := range tc.expNoKey {
+				_, err := kb.Key(name)
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -42,6 +42,7 @@ The pass backend requires GnuPG: https://gnupg.org/
 		ExportKeyCommand(),
 		ImportKeyCommand(),
 		ImportKeyHexCommand(),
+		ImportMnemonicsCommand(),
 		ListKeysCmd(),
 		ListKeyTypesCmd(),
 		ShowKeysCmd(),
@@ -4,11 +4,13 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"time"
 
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 
 	"cosmossdk.io/collections"
 	storetypes "cosmossdk.io/store/types"
+	vestingtypes "cosmossdk.io/x/auth/vesting/types"
 	slashingtypes "cosmossdk.io/x/slashing/types"
 	"cosmossdk.io/x/staking"
 	stakingtypes "cosmossdk.io/x/staking/types"
@@ -20,6 +22,13 @@ import (
 // ExportAppStateAndValidators exports the state of the application for a genesis
 // file.
 func (app *SimApp) ExportAppStateAndValidators(forZeroHeight bool, jailAllowedAddrs, modulesToExport []string) (servertypes.ExportedApp, error) {
+	return app.ExportAppStateAndValidatorsAt(time.Time{}, forZeroHeight, jailAllowedAddrs, modulesToExport)
+}
+
+// ExportAppStateAndValidatorsAt exports the state of the application for a
+// genesis file whose chain starts at genesisTime. When exporting for zero height
+// with a non-zero genesisTime, vesting schedules are rebased on genesisTime.
+func (app *SimApp) ExportAppStateAndValidatorsAt(genesisTime time.Time, forZeroHeight bool, jailAllowedAddrs, modulesToExport []string) (servertypes.ExportedApp, error) {
 	// as if they could withdraw from the start of the next block
 	ctx := app.NewContextLegacy(true, cmtproto.Header{Height: app.LastBlockHeight()})
 
@@ -28,7 +37,7 @@ func (app *SimApp) ExportAppStateAndValidators(forZeroHeight bool, jailAllowedAd
 	height := app.LastBlockHeight() + 1
 	if forZeroHeight {
 		height = 0
-		app.prepForZeroHeightGenesis(ctx, jailAllowedAddrs)
+		app.prepForZeroHeightGenesis(ctx, jailAllowedAddrs, genesisTime)
 	}
 
 	genState, err := app.ModuleManager.ExportGenesisForModules(ctx, modulesToExport)
@@ -54,7 +63,7 @@ func (app *SimApp) ExportAppStateAndValidators(forZeroHeight bool, jailAllowedAd
 // NOTE zero height genesis is a temporary feature which will be deprecated
 //
 //	in favor of export at a block height
-func (app *SimApp) prepForZeroHeightGenesis(ctx sdk.Context, jailAllowedAddrs []string) {
+func (app *SimApp) prepForZeroHeightGenesis(ctx sdk.Context, jailAllowedAddrs []string, genesisTime time.Time) {
 	applyAllowedAddrs := false
 
 	// check if there is a allowed address list
@@ -254,4 +263,88 @@ func (app *SimApp) prepForZeroHeightGenesis(ctx sdk.Context, jailAllowedAddrs []
 	if err != nil {
 		panic(err)
 	}
+
+	/* Handle vesting state. */
+
+	if genesisTime.IsZero() {
+		return
+	}
+
+	// rebase vesting schedules on the genesis time, so that the new chain does
+	// not start with schedules that have already (partially) elapsed
+	var vestingAccs []sdk.AccountI
+	err = app.AuthKeeper.Accounts.Walk(ctx, nil, func(_ sdk.AccAddress, acc sdk.AccountI) (stop bool, err error) {
+		if rebased, ok := rebaseVestingAccount(acc, genesisTime); ok {
+			vestingAccs = append(vestingAccs, rebased)
+		}
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	for _, acc := range vestingAccs {
+		app.AuthKeeper.SetAccount(ctx, acc)
+	}
+}
+
+// rebaseVestingAccount rewrites the vesting schedule of acc so that it starts
+// at genesisTime. Fully vested accounts are turned into base accounts, accounts
+// whose schedule is in progress only keep their remaining vesting coins, and
+// accounts whose schedule has not started are left untouched. It returns false
+// when acc does not need to be rewritten.
+func rebaseVestingAccount(acc sdk.AccountI, genesisTime time.Time) (sdk.AccountI, bool) {
+	now := genesisTime.Unix()
+
+	switch acc := acc.(type) {
+	case *vestingtypes.ContinuousVestingAccount:
+		if acc.EndTime <= now {
+			return acc.BaseAccount, true
+		}
+		if acc.StartTime >= now {
+			return nil, false
+		}
+
+		rebaseBaseVestingAccount(acc.BaseVestingAccount, acc.GetVestingCoins(genesisTime))
+		acc.StartTime = now
+		return acc, true
+
+	case *vestingtypes.PeriodicVestingAccount:
+		if acc.EndTime <= now {
+			return acc.BaseAccount, true
+		}
+
+		// drop the periods that have fully elapsed
+		startTime, i := acc.StartTime, 0
+		for ; i < len(acc.VestingPeriods) && startTime+acc.VestingPeriods[i].Length <= now; i++ {
+			startTime += acc.VestingPeriods[i].Length
+		}
+		if i == 0 {
+			return nil, false
+		}
+
+		periods := vestingtypes.Periods(acc.VestingPeriods[i:])
+		rebaseBaseVestingAccount(acc.BaseVestingAccount, periods.TotalAmount())
+		acc.StartTime, acc.VestingPeriods = startTime, periods
+		return acc, true
+
+	case *vestingtypes.DelayedVestingAccount:
+		if acc.EndTime <= now {
+			return acc.BaseAccount, true
+		}
+		return nil, false
+
+	default:
+		return nil, false
+	}
+}
+
+// rebaseBaseVestingAccount sets the original vesting coins of bva to the given
+// still vesting coins, moving the delegated vesting coins in excess to the
+// delegated free coins.
+func rebaseBaseVestingAccount(bva *vestingtypes.BaseVestingAccount, vestingCoins sdk.Coins) {
+	delegatedVesting := bva.DelegatedVesting.Min(vestingCoins)
+	bva.DelegatedFree = bva.DelegatedFree.Add(bva.DelegatedVesting.Sub(delegatedVesting...)...)
+	bva.DelegatedVesting = delegatedVesting
+	bva.OriginalVesting = vestingCoins
 }
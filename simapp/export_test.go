@@ -0,0 +1,120 @@
+package simapp
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	authtypes "cosmossdk.io/x/auth/types"
+	vestingtypes "cosmossdk.io/x/auth/vesting/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestExportZeroHeightVestingAccounts(t *testing.T) {
+	app := NewSimappWithCustomOptions(t, false, SetupOptions{
+		Logger:  log.NewNopLogger(),
+		DB:      dbm.NewMemDB(),
+		AppOpts: simtestutil.NewAppOptionsWithFlagHome(t.TempDir()),
+	})
+
+	_, err := app.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 1})
+	require.NoError(t, err)
+	_, err = app.Commit()
+	require.NoError(t, err)
+
+	genesisTime := time.Unix(1700000000, 0).UTC()
+	ctx := app.NewContext(true)
+
+	newBaseAccount := func() *authtypes.BaseAccount {
+		addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+		return app.AuthKeeper.NewAccountWithAddress(ctx, addr).(*authtypes.BaseAccount)
+	}
+
+	continuousAcc, err := vestingtypes.NewContinuousVestingAccount(newBaseAccount(), sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)), genesisTime.Add(-time.Hour).Unix(), genesisTime.Add(time.Hour).Unix())
+	require.NoError(t, err)
+	continuousAcc.DelegatedVesting = sdk.NewCoins(sdk.NewInt64Coin("stake", 800))
+	app.AuthKeeper.SetAccount(ctx, continuousAcc)
+
+	pendingAcc, err := vestingtypes.NewContinuousVestingAccount(newBaseAccount(), sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)), genesisTime.Add(time.Hour).Unix(), genesisTime.Add(2*time.Hour).Unix())
+	require.NoError(t, err)
+	app.AuthKeeper.SetAccount(ctx, pendingAcc)
+
+	delayedAcc, err := vestingtypes.NewDelayedVestingAccount(newBaseAccount(), sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)), genesisTime.Add(-time.Hour).Unix())
+	require.NoError(t, err)
+	app.AuthKeeper.SetAccount(ctx, delayedAcc)
+
+	hour := int64(time.Hour.Seconds())
+	periodicAcc, err := vestingtypes.NewPeriodicVestingAccount(newBaseAccount(), sdk.NewCoins(sdk.NewInt64Coin("stake", 600)), genesisTime.Add(-2*time.Hour).Unix(), vestingtypes.Periods{
+		{Length: hour, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 100))},
+		{Length: 2 * hour, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 200))},
+		{Length: hour, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 300))},
+	})
+	require.NoError(t, err)
+	app.AuthKeeper.SetAccount(ctx, periodicAcc)
+
+	exportAccounts := func(exported servertypes.ExportedApp) map[string]authtypes.GenesisAccount {
+		var genState map[string]json.RawMessage
+		require.NoError(t, json.Unmarshal(exported.AppState, &genState))
+
+		var authGenState authtypes.GenesisState
+		require.NoError(t, app.AppCodec().UnmarshalJSON(genState[authtypes.ModuleName], &authGenState))
+		genAccs, err := authtypes.UnpackAccounts(authGenState.Accounts)
+		require.NoError(t, err)
+
+		accs := make(map[string]authtypes.GenesisAccount, len(genAccs))
+		for _, acc := range genAccs {
+			require.NoError(t, acc.Validate())
+			accs[acc.GetAddress().String()] = acc
+		}
+		return accs
+	}
+
+	// without a genesis time the vesting schedules are kept as is
+	exported, err := app.ExportAppStateAndValidators(true, []string{}, []string{})
+	require.NoError(t, err)
+	accs := exportAccounts(exported)
+	require.Equal(t, continuousAcc, accs[continuousAcc.GetAddress().String()])
+	require.Equal(t, delayedAcc, accs[delayedAcc.GetAddress().String()])
+	require.Equal(t, periodicAcc, accs[periodicAcc.GetAddress().String()])
+
+	exported, err = app.ExportAppStateAndValidatorsAt(genesisTime, true, []string{}, []string{})
+	require.NoError(t, err)
+	accs = exportAccounts(exported)
+
+	// the continuous schedule starts at the genesis time with its remaining
+	// vesting coins, delegations above them become free
+	continuous, ok := accs[continuousAcc.GetAddress().String()].(*vestingtypes.ContinuousVestingAccount)
+	require.True(t, ok)
+	require.Equal(t, genesisTime.Unix(), continuous.StartTime)
+	require.Equal(t, continuousAcc.EndTime, continuous.EndTime)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 500)), continuous.OriginalVesting)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 500)), continuous.DelegatedVesting)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 300)), continuous.DelegatedFree)
+
+	// a schedule that has not started yet is untouched
+	require.Equal(t, pendingAcc, accs[pendingAcc.GetAddress().String()])
+
+	// a fully vested account becomes a base account
+	require.Equal(t, delayedAcc.BaseAccount, accs[delayedAcc.GetAddress().String()])
+
+	// elapsed periods are dropped and the schedule starts at the first
+	// remaining period
+	periodic, ok := accs[periodicAcc.GetAddress().String()].(*vestingtypes.PeriodicVestingAccount)
+	require.True(t, ok)
+	require.Equal(t, genesisTime.Add(-time.Hour).Unix(), periodic.StartTime)
+	require.Equal(t, periodicAcc.EndTime, periodic.EndTime)
+	require.Equal(t, []vestingtypes.Period{
+		{Length: 2 * hour, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 200))},
+		{Length: hour, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 300))},
+	}, periodic.VestingPeriods)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 500)), periodic.OriginalVesting)
+}
@@ -2,8 +2,10 @@ package simapp
 
 import (
 	"errors"
+	"fmt"
 
 	authtypes "cosmossdk.io/x/auth/types"
+	vestingtypes "cosmossdk.io/x/auth/vesting/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -15,12 +17,18 @@ var _ authtypes.GenesisAccount = (*SimGenesisAccount)(nil)
 type SimGenesisAccount struct {
 	*authtypes.BaseAccount
 
+	// balance of the account at genesis
+	Coins sdk.Coins `json:"coins" yaml:"coins"`
+
 	// vesting account fields
 	OriginalVesting  sdk.Coins `json:"original_vesting" yaml:"original_vesting"`   // total vesting coins upon initialization
 	DelegatedFree    sdk.Coins `json:"delegated_free" yaml:"delegated_free"`       // delegated vested coins at time of delegation
 	DelegatedVesting sdk.Coins `json:"delegated_vesting" yaml:"delegated_vesting"` // delegated vesting coins at time of delegation
 	StartTime        int64     `json:"start_time" yaml:"start_time"`               // vesting start time (UNIX Epoch time)
 	EndTime          int64     `json:"end_time" yaml:"end_time"`                   // vesting end time (UNIX Epoch time)
+	Continuous       bool      `json:"continuous" yaml:"continuous"`               // vests linearly between start and end time instead of all at end time
+
+	VestingPeriods vestingtypes.Periods `json:"vesting_periods" yaml:"vesting_periods"` // vesting periods, starting at start time
 
 	// module account fields
 	ModuleName        string   `json:"module_name" yaml:"module_name"`               // name of the module account
@@ -33,6 +41,36 @@ func (sga SimGenesisAccount) Validate() error {
 		if sga.StartTime >= sga.EndTime {
 			return errors.New("vesting start-time cannot be before end-time")
 		}
+
+		if !sga.Coins.IsAllGTE(sga.OriginalVesting) {
+			return fmt.Errorf("original vesting coins (%s) exceed the account coins (%s)", sga.OriginalVesting, sga.Coins)
+		}
+	}
+
+	if sga.Continuous && sga.OriginalVesting.IsZero() {
+		return errors.New("continuous vesting account must have original vesting coins")
+	}
+
+	if len(sga.VestingPeriods) > 0 {
+		if sga.OriginalVesting.IsZero() {
+			return errors.New("vesting periods cannot be set without original vesting coins")
+		}
+
+		if sga.Continuous {
+			return errors.New("vesting periods cannot be set on a continuous vesting account")
+		}
+
+		if total := sga.VestingPeriods.TotalAmount(); !total.Equal(sga.OriginalVesting) {
+			return fmt.Errorf("original vesting coins (%s) does not match the sum of all coins in vesting periods (%s)", sga.OriginalVesting, total)
+		}
+
+		if sga.StartTime+sga.VestingPeriods.TotalLength() != sga.EndTime {
+			return errors.New("vesting end time does not match length of all vesting periods")
+		}
+	}
+
+	if err := validateModulePermissions(sga.ModuleName, sga.ModulePermissions); err != nil {
+		return err
 	}
 
 	if sga.ModuleName != "" {
@@ -46,3 +84,72 @@ func (sga SimGenesisAccount) Validate() error {
 
 	return sga.BaseAccount.Validate()
 }
+
+// ToGenesisAccount converts the simulation account into the account type it
+// describes: a module account, a continuous, periodic or delayed vesting
+// account, or a base account.
+func (sga SimGenesisAccount) ToGenesisAccount() (authtypes.GenesisAccount, error) {
+	if err := sga.Validate(); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case sga.ModuleName != "":
+		return &authtypes.ModuleAccount{
+			BaseAccount: sga.BaseAccount, Name: sga.ModuleName, Permissions: sga.ModulePermissions,
+		}, nil
+
+	case sga.OriginalVesting.IsZero():
+		return sga.BaseAccount, nil
+
+	case sga.Continuous:
+		acc, err := vestingtypes.NewContinuousVestingAccount(sga.BaseAccount, sga.OriginalVesting, sga.StartTime, sga.EndTime)
+		if err != nil {
+			return nil, err
+		}
+		acc.DelegatedFree, acc.DelegatedVesting = sga.DelegatedFree, sga.DelegatedVesting
+		return acc, nil
+
+	case len(sga.VestingPeriods) > 0:
+		acc, err := vestingtypes.NewPeriodicVestingAccount(sga.BaseAccount, sga.OriginalVesting, sga.StartTime, sga.VestingPeriods)
+		if err != nil {
+			return nil, err
+		}
+		acc.DelegatedFree, acc.DelegatedVesting = sga.DelegatedFree, sga.DelegatedVesting
+		return acc, nil
+
+	default:
+		acc, err := vestingtypes.NewDelayedVestingAccount(sga.BaseAccount, sga.OriginalVesting, sga.EndTime)
+		if err != nil {
+			return nil, err
+		}
+		acc.DelegatedFree, acc.DelegatedVesting = sga.DelegatedFree, sga.DelegatedVesting
+		return acc, nil
+	}
+}
+
+// validateModulePermissions checks that only module accounts carry permissions
+// and that each permission is known and granted at most once.
+func validateModulePermissions(moduleName string, permissions []string) error {
+	if moduleName == "" && len(permissions) > 0 {
+		return errors.New("only module accounts can have permissions")
+	}
+
+	seen := make(map[string]struct{}, len(permissions))
+	for _, perm := range permissions {
+		switch perm {
+		case authtypes.Minter, authtypes.Burner, authtypes.Staking:
+		case "":
+			return errors.New("module permission is empty")
+		default:
+			return fmt.Errorf("unknown module permission %q", perm)
+		}
+
+		if _, ok := seen[perm]; ok {
+			return fmt.Errorf("duplicate module permission %q", perm)
+		}
+		seen[perm] = struct{}{}
+	}
+
+	return nil
+}
@@ -5,12 +5,15 @@ import (
 	"time"
 
 	"github.com/cometbft/cometbft/crypto"
+	cmttypes "github.com/cometbft/cometbft/types"
 	"github.com/stretchr/testify/require"
 
 	"cosmossdk.io/simapp"
 	authtypes "cosmossdk.io/x/auth/types"
+	vestingtypes "cosmossdk.io/x/auth/vesting/types"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/testutil/mock"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -22,6 +25,10 @@ func TestSimGenesisAccountValidate(t *testing.T) {
 
 	coins := sdk.NewCoins(sdk.NewInt64Coin("test", 1000))
 	baseAcc := authtypes.NewBaseAccount(addr, pubkey, 0, 0)
+	periods := vestingtypes.Periods{
+		{Length: int64(time.Hour.Seconds()), Amount: sdk.NewCoins(sdk.NewInt64Coin("test", 400))},
+		{Length: int64(time.Hour.Seconds()), Amount: sdk.NewCoins(sdk.NewInt64Coin("test", 600))},
+	}
 
 	testCases := []struct {
 		name    string
@@ -50,6 +57,50 @@ func TestSimGenesisAccountValidate(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"valid module account with permissions",
+			simapp.SimGenesisAccount{
+				BaseAccount:       authtypes.NewBaseAccount(sdk.AccAddress(crypto.AddressHash([]byte("testmod"))), nil, 0, 0),
+				ModuleName:        "testmod",
+				ModulePermissions: []string{authtypes.Minter, authtypes.Burner, authtypes.Staking},
+			},
+			false,
+		},
+		{
+			"invalid module account with empty permission",
+			simapp.SimGenesisAccount{
+				BaseAccount:       authtypes.NewBaseAccount(sdk.AccAddress(crypto.AddressHash([]byte("testmod"))), nil, 0, 0),
+				ModuleName:        "testmod",
+				ModulePermissions: []string{authtypes.Minter, ""},
+			},
+			true,
+		},
+		{
+			"invalid module account with unknown permission",
+			simapp.SimGenesisAccount{
+				BaseAccount:       authtypes.NewBaseAccount(sdk.AccAddress(crypto.AddressHash([]byte("testmod"))), nil, 0, 0),
+				ModuleName:        "testmod",
+				ModulePermissions: []string{"printer"},
+			},
+			true,
+		},
+		{
+			"invalid module account with duplicate permissions",
+			simapp.SimGenesisAccount{
+				BaseAccount:       authtypes.NewBaseAccount(sdk.AccAddress(crypto.AddressHash([]byte("testmod"))), nil, 0, 0),
+				ModuleName:        "testmod",
+				ModulePermissions: []string{authtypes.Burner, authtypes.Burner},
+			},
+			true,
+		},
+		{
+			"invalid basic account with permissions",
+			simapp.SimGenesisAccount{
+				BaseAccount:       baseAcc,
+				ModulePermissions: []string{authtypes.Minter},
+			},
+			true,
+		},
 		{
 			"valid basic account with invalid module name/pubkey pair",
 			simapp.SimGenesisAccount{
@@ -62,12 +113,24 @@ func TestSimGenesisAccountValidate(t *testing.T) {
 			"valid basic account with valid vesting attributes",
 			simapp.SimGenesisAccount{
 				BaseAccount:     baseAcc,
+				Coins:           coins,
 				OriginalVesting: coins,
 				StartTime:       vestingStart.Unix(),
 				EndTime:         vestingStart.Add(1 * time.Hour).Unix(),
 			},
 			false,
 		},
+		{
+			"invalid delayed vesting account with original vesting exceeding coins",
+			simapp.SimGenesisAccount{
+				BaseAccount:     baseAcc,
+				Coins:           sdk.NewCoins(sdk.NewInt64Coin("test", 999)),
+				OriginalVesting: coins,
+				StartTime:       vestingStart.Unix(),
+				EndTime:         vestingStart.Add(1 * time.Hour).Unix(),
+			},
+			true,
+		},
 		{
 			"valid basic account with invalid vesting end time",
 			simapp.SimGenesisAccount{
@@ -78,6 +141,126 @@ func TestSimGenesisAccountValidate(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"valid continuous vesting account",
+			simapp.SimGenesisAccount{
+				BaseAccount:     baseAcc,
+				Coins:           coins,
+				OriginalVesting: coins,
+				StartTime:       vestingStart.Unix(),
+				EndTime:         vestingStart.Add(1 * time.Hour).Unix(),
+				Continuous:      true,
+			},
+			false,
+		},
+		{
+			"invalid continuous vesting account with start time after end time",
+			simapp.SimGenesisAccount{
+				BaseAccount:     baseAcc,
+				Coins:           coins,
+				OriginalVesting: coins,
+				StartTime:       vestingStart.Add(2 * time.Hour).Unix(),
+				EndTime:         vestingStart.Add(1 * time.Hour).Unix(),
+				Continuous:      true,
+			},
+			true,
+		},
+		{
+			"invalid continuous vesting account with original vesting exceeding coins",
+			simapp.SimGenesisAccount{
+				BaseAccount:     baseAcc,
+				Coins:           sdk.NewCoins(sdk.NewInt64Coin("test", 999)),
+				OriginalVesting: coins,
+				StartTime:       vestingStart.Unix(),
+				EndTime:         vestingStart.Add(1 * time.Hour).Unix(),
+				Continuous:      true,
+			},
+			true,
+		},
+		{
+			"invalid continuous vesting account without original vesting",
+			simapp.SimGenesisAccount{
+				BaseAccount: baseAcc,
+				Coins:       coins,
+				StartTime:   vestingStart.Unix(),
+				EndTime:     vestingStart.Add(1 * time.Hour).Unix(),
+				Continuous:  true,
+			},
+			true,
+		},
+		{
+			"valid periodic vesting account",
+			simapp.SimGenesisAccount{
+				BaseAccount:     baseAcc,
+				Coins:           coins,
+				OriginalVesting: coins,
+				StartTime:       vestingStart.Unix(),
+				EndTime:         vestingStart.Add(2 * time.Hour).Unix(),
+				VestingPeriods:  periods,
+			},
+			false,
+		},
+		{
+			"invalid periodic vesting account without coins",
+			simapp.SimGenesisAccount{
+				BaseAccount:     baseAcc,
+				OriginalVesting: coins,
+				StartTime:       vestingStart.Unix(),
+				EndTime:         vestingStart.Add(2 * time.Hour).Unix(),
+				VestingPeriods:  periods,
+			},
+			true,
+		},
+		{
+			"invalid periodic vesting account with mismatched period sum",
+			simapp.SimGenesisAccount{
+				BaseAccount:     baseAcc,
+				Coins:           coins,
+				OriginalVesting: sdk.NewCoins(sdk.NewInt64Coin("test", 999)),
+				StartTime:       vestingStart.Unix(),
+				EndTime:         vestingStart.Add(2 * time.Hour).Unix(),
+				VestingPeriods:  periods,
+			},
+			true,
+		},
+		{
+			"invalid periodic vesting account with misaligned end time",
+			simapp.SimGenesisAccount{
+				BaseAccount:     baseAcc,
+				Coins:           coins,
+				OriginalVesting: coins,
+				StartTime:       vestingStart.Unix(),
+				EndTime:         vestingStart.Add(3 * time.Hour).Unix(),
+				VestingPeriods:  periods,
+			},
+			true,
+		},
+		{
+			"invalid periodic vesting account without original vesting",
+			simapp.SimGenesisAccount{
+				BaseAccount: baseAcc,
+				StartTime:   vestingStart.Unix(),
+				EndTime:     vestingStart.Add(2 * time.Hour).Unix(),
+				VestingPeriods: vestingtypes.Periods{
+					{Length: int64(time.Hour.Seconds())},
+					{Length: int64(time.Hour.Seconds())},
+				},
+			},
+			true,
+		},
+		{
+			"invalid periodic vesting account marked continuous",
+			simapp.SimGenesisAccount{
+				BaseAccount:     baseAcc,
+				Coins:           coins,
+				OriginalVesting: coins,
+				StartTime:       vestingStart.Unix(),
+				EndTime:         vestingStart.Add(2 * time.Hour).Unix(),
+				Continuous:      true,
+				VestingPeriods:  periods,
+			},
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -87,3 +270,128 @@ func TestSimGenesisAccountValidate(t *testing.T) {
 		})
 	}
 }
+
+func TestSimGenesisAccountToGenesisAccount(t *testing.T) {
+	pubkey := secp256k1.GenPrivKey().PubKey()
+	addr := sdk.AccAddress(pubkey.Address())
+
+	vestingStart := time.Now().UTC()
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin("test", 1000))
+	baseAcc := authtypes.NewBaseAccount(addr, pubkey, 0, 0)
+	periods := vestingtypes.Periods{
+		{Length: int64(time.Hour.Seconds()), Amount: sdk.NewCoins(sdk.NewInt64Coin("test", 400))},
+		{Length: int64(time.Hour.Seconds()), Amount: sdk.NewCoins(sdk.NewInt64Coin("test", 600))},
+	}
+
+	testCases := []struct {
+		name    string
+		sga     simapp.SimGenesisAccount
+		expType authtypes.GenesisAccount
+	}{
+		{
+			"base account",
+			simapp.SimGenesisAccount{
+				BaseAccount: baseAcc,
+			},
+			&authtypes.BaseAccount{},
+		},
+		{
+			"module account",
+			simapp.SimGenesisAccount{
+				BaseAccount: authtypes.NewBaseAccount(sdk.AccAddress(crypto.AddressHash([]byte("testmod"))), nil, 0, 0),
+				ModuleName:  "testmod",
+			},
+			&authtypes.ModuleAccount{},
+		},
+		{
+			"delayed vesting account",
+			simapp.SimGenesisAccount{
+				BaseAccount:     baseAcc,
+				Coins:           coins,
+				OriginalVesting: coins,
+				StartTime:       vestingStart.Unix(),
+				EndTime:         vestingStart.Add(1 * time.Hour).Unix(),
+			},
+			&vestingtypes.DelayedVestingAccount{},
+		},
+		{
+			"continuous vesting account",
+			simapp.SimGenesisAccount{
+				BaseAccount:     baseAcc,
+				Coins:           coins,
+				OriginalVesting: coins,
+				StartTime:       vestingStart.Unix(),
+				EndTime:         vestingStart.Add(1 * time.Hour).Unix(),
+				Continuous:      true,
+			},
+			&vestingtypes.ContinuousVestingAccount{},
+		},
+		{
+			"periodic vesting account",
+			simapp.SimGenesisAccount{
+				BaseAccount:     baseAcc,
+				Coins:           coins,
+				OriginalVesting: coins,
+				StartTime:       vestingStart.Unix(),
+				EndTime:         vestingStart.Add(2 * time.Hour).Unix(),
+				VestingPeriods:  periods,
+			},
+			&vestingtypes.PeriodicVestingAccount{},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			acc, err := tc.sga.ToGenesisAccount()
+			require.NoError(t, err)
+			require.IsType(t, tc.expType, acc)
+			require.NoError(t, acc.Validate())
+		})
+	}
+}
+
+func TestSetupWithSimGenesisAccounts(t *testing.T) {
+	privVal := mock.NewPV()
+	pubKey, err := privVal.GetPubKey()
+	require.NoError(t, err)
+	valSet := cmttypes.NewValidatorSet([]*cmttypes.Validator{cmttypes.NewValidator(pubKey, 1)})
+
+	delegatorKey := secp256k1.GenPrivKey().PubKey()
+	delegator := authtypes.NewBaseAccount(sdk.AccAddress(delegatorKey.Address()), delegatorKey, 0, 0)
+
+	vestingKey := secp256k1.GenPrivKey().PubKey()
+	vestingAddr := sdk.AccAddress(vestingKey.Address())
+
+	vestingStart := time.Unix(1700000000, 0).UTC()
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+	periods := vestingtypes.Periods{
+		{Length: int64(time.Hour.Seconds()), Amount: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 400))},
+		{Length: int64(time.Hour.Seconds()), Amount: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 600))},
+	}
+
+	app := simapp.SetupWithSimGenesisAccounts(t, valSet,
+		simapp.SimGenesisAccount{
+			BaseAccount: delegator,
+			Coins:       sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100000000)),
+		},
+		simapp.SimGenesisAccount{
+			BaseAccount:     authtypes.NewBaseAccount(vestingAddr, vestingKey, 1, 0),
+			Coins:           coins,
+			OriginalVesting: coins,
+			StartTime:       vestingStart.Unix(),
+			EndTime:         vestingStart.Add(2 * time.Hour).Unix(),
+			VestingPeriods:  periods,
+		},
+	)
+	ctx := app.NewContext(true)
+
+	acc, ok := app.AuthKeeper.GetAccount(ctx, vestingAddr).(*vestingtypes.PeriodicVestingAccount)
+	require.True(t, ok)
+	require.Equal(t, coins, acc.OriginalVesting)
+	require.Equal(t, vestingStart.Unix(), acc.StartTime)
+	require.Equal(t, vestingStart.Add(2*time.Hour).Unix(), acc.EndTime)
+	require.Equal(t, periods, vestingtypes.Periods(acc.VestingPeriods))
+	require.Equal(t, coins, app.BankKeeper.GetAllBalances(ctx, vestingAddr))
+}
@@ -2,8 +2,11 @@ package cmd
 
 import (
 	"errors"
+	"fmt"
 	"io"
 	"os"
+	"slices"
+	"time"
 
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/spf13/cobra"
@@ -30,6 +33,17 @@ import (
 	genutilcli "github.com/cosmos/cosmos-sdk/x/genutil/client/cli"
 )
 
+const (
+	// flagModulesExportMode selects how the modules passed to the export command
+	// through --modules-to-export are interpreted.
+	flagModulesExportMode = "modules-export-mode"
+
+	// modulesExportModeAllow exports only the given modules.
+	modulesExportModeAllow = "allow"
+	// modulesExportModeDeny exports every module except the given ones.
+	modulesExportModeDeny = "deny"
+)
+
 func initRootCmd(
 	rootCmd *cobra.Command,
 	txConfig client.TxConfig,
@@ -64,12 +78,28 @@ func initRootCmd(
 func genesisCommand(txConfig client.TxConfig, moduleManager *module.Manager, appExport servertypes.AppExporter, cmds ...*cobra.Command) *cobra.Command {
 	cmd := genutilcli.Commands(txConfig, moduleManager, appExport)
 
+	exportCmd := findSubCommand(cmd, "export")
+	if exportCmd == nil {
+		panic("genesis command has no export subcommand to register the modules export mode flag on")
+	}
+	exportCmd.Flags().String(flagModulesExportMode, modulesExportModeAllow, fmt.Sprintf("How --modules-to-export is interpreted: %s exports only the given modules, %s exports all modules except the given ones", modulesExportModeAllow, modulesExportModeDeny))
+
 	for _, subCmd := range cmds {
 		cmd.AddCommand(subCmd)
 	}
 	return cmd
 }
 
+// findSubCommand returns the direct subcommand of cmd with the given name, or nil.
+func findSubCommand(cmd *cobra.Command, name string) *cobra.Command {
+	for _, subCmd := range cmd.Commands() {
+		if subCmd.Name() == name {
+			return subCmd
+		}
+	}
+	return nil
+}
+
 func queryCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:                        "query",
@@ -80,14 +110,14 @@ func queryCommand() *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
-	cmd.AddCommand(
+	cmd.AddCommand(withQueryOutput(
 		rpc.WaitTxCmd(),
 		server.QueryBlockCmd(),
 		authcmd.QueryTxsByEventsCmd(),
 		server.QueryBlocksCmd(),
 		authcmd.QueryTxCmd(),
 		server.QueryBlockResultsCmd(),
-	)
+	)...)
 
 	return cmd
 }
@@ -169,7 +199,54 @@ func appExport(
 		simApp = simapp.NewSimApp(logger, db, traceStore, true, appOpts)
 	}
 
-	return simApp.ExportAppStateAndValidators(forZeroHeight, jailAllowedAddrs, modulesToExport)
+	modulesToExport, err := resolveModulesToExport(simApp.ModuleManager.OrderExportGenesis, appOpts, modulesToExport)
+	if err != nil {
+		return servertypes.ExportedApp{}, err
+	}
+
+	// zero-height exports rebase vesting schedules on the genesis time of the
+	// new chain, which is only known when it is set with --genesis-time
+	var genesisTime time.Time
+	if genesisTimeStr, _ := appOpts.Get(genutilcli.FlagGenesisTime).(string); forZeroHeight && genesisTimeStr != "" {
+		if err := genesisTime.UnmarshalText([]byte(genesisTimeStr)); err != nil {
+			return servertypes.ExportedApp{}, fmt.Errorf("failed to unmarshal genesis time: %w", err)
+		}
+	}
+
+	return simApp.ExportAppStateAndValidatorsAt(genesisTime, forZeroHeight, jailAllowedAddrs, modulesToExport)
+}
+
+// resolveModulesToExport returns the modules to export according to the modules
+// export mode set in appOpts. In deny mode, the given modules are removed from
+// the app modules, which are returned in export order.
+func resolveModulesToExport(appModules []string, appOpts servertypes.AppOptions, modules []string) ([]string, error) {
+	mode, _ := appOpts.Get(flagModulesExportMode).(string)
+	switch mode {
+	case "", modulesExportModeAllow:
+		return modules, nil
+	case modulesExportModeDeny:
+	default:
+		return nil, fmt.Errorf("unknown modules export mode %q, expected %s or %s", mode, modulesExportModeAllow, modulesExportModeDeny)
+	}
+
+	for _, module := range modules {
+		if !slices.Contains(appModules, module) {
+			return nil, fmt.Errorf("module %s does not exist", module)
+		}
+	}
+
+	var modulesToExport []string
+	for _, module := range appModules {
+		if !slices.Contains(modules, module) {
+			modulesToExport = append(modulesToExport, module)
+		}
+	}
+
+	if len(modulesToExport) == 0 {
+		return nil, errors.New("all modules are excluded from the export")
+	}
+
+	return modulesToExport, nil
 }
 
 var tempDir = func() string {
@@ -0,0 +1,213 @@
+package cmd
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/simapp"
+	authtypes "cosmossdk.io/x/auth/types"
+	vestingtypes "cosmossdk.io/x/auth/vesting/types"
+	banktypes "cosmossdk.io/x/bank/types"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	genutilcli "github.com/cosmos/cosmos-sdk/x/genutil/client/cli"
+)
+
+func TestAppExportModulesExportMode(t *testing.T) {
+	db := dbm.NewMemDB()
+	app := simapp.NewSimappWithCustomOptions(t, false, simapp.SetupOptions{
+		Logger:  log.NewNopLogger(),
+		DB:      db,
+		AppOpts: simtestutil.NewAppOptionsWithFlagHome(t.TempDir()),
+	})
+
+	_, err := app.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 1})
+	require.NoError(t, err)
+	_, err = app.Commit()
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name       string
+		mode       string
+		modules    []string
+		expErr     string
+		expPresent []string
+		expAbsent  []string
+	}{
+		{
+			name:       "default mode is an allowlist",
+			modules:    []string{banktypes.ModuleName},
+			expPresent: []string{banktypes.ModuleName},
+			expAbsent:  []string{authtypes.ModuleName},
+		},
+		{
+			name:       "allowlist",
+			mode:       modulesExportModeAllow,
+			modules:    []string{banktypes.ModuleName},
+			expPresent: []string{banktypes.ModuleName},
+			expAbsent:  []string{authtypes.ModuleName},
+		},
+		{
+			name:       "denylist",
+			mode:       modulesExportModeDeny,
+			modules:    []string{banktypes.ModuleName},
+			expPresent: []string{authtypes.ModuleName},
+			expAbsent:  []string{banktypes.ModuleName},
+		},
+		{
+			name:    "denylist with unknown module",
+			mode:    modulesExportModeDeny,
+			modules: []string{"unknown"},
+			expErr:  "module unknown does not exist",
+		},
+		{
+			name:    "denylist with all modules",
+			mode:    modulesExportModeDeny,
+			modules: app.ModuleManager.OrderExportGenesis,
+			expErr:  "all modules are excluded from the export",
+		},
+		{
+			name:    "unknown mode",
+			mode:    "both",
+			modules: []string{banktypes.ModuleName},
+			expErr:  "unknown modules export mode",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			appOpts := viper.New()
+			appOpts.Set(flags.FlagHome, t.TempDir())
+			if tc.mode != "" {
+				appOpts.Set(flagModulesExportMode, tc.mode)
+			}
+
+			exported, err := appExport(log.NewNopLogger(), db, nil, -1, false, nil, appOpts, tc.modules)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+
+			var genState map[string]json.RawMessage
+			require.NoError(t, json.Unmarshal(exported.AppState, &genState))
+
+			for _, module := range tc.expPresent {
+				require.Contains(t, genState, module)
+			}
+			for _, module := range tc.expAbsent {
+				require.NotContains(t, genState, module)
+			}
+
+			// the exported modules, completed with the default genesis of the
+			// excluded ones, must form a valid genesis
+			fullGenState := app.DefaultGenesis()
+			for module, bz := range genState {
+				fullGenState[module] = bz
+			}
+			require.NoError(t, app.ModuleManager.ValidateGenesis(fullGenState))
+		})
+	}
+}
+
+func TestAppExportZeroHeightGenesisTime(t *testing.T) {
+	db := dbm.NewMemDB()
+	app := simapp.NewSimappWithCustomOptions(t, false, simapp.SetupOptions{
+		Logger:  log.NewNopLogger(),
+		DB:      db,
+		AppOpts: simtestutil.NewAppOptionsWithFlagHome(t.TempDir()),
+	})
+
+	genesisTime := time.Unix(1700000000, 0).UTC()
+	blockTime := genesisTime.Add(-30 * time.Minute)
+
+	_, err := app.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 1, Time: blockTime})
+	require.NoError(t, err)
+
+	// write the vesting account to the committed state
+	ctx := app.NewUncachedContext(false, cmtproto.Header{Height: 1, Time: blockTime})
+	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	baseAcc := app.AuthKeeper.NewAccountWithAddress(ctx, addr).(*authtypes.BaseAccount)
+	vestingAcc, err := vestingtypes.NewContinuousVestingAccount(baseAcc, sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)), genesisTime.Add(-time.Hour).Unix(), genesisTime.Add(time.Hour).Unix())
+	require.NoError(t, err)
+	app.AuthKeeper.SetAccount(ctx, vestingAcc)
+
+	_, err = app.Commit()
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name        string
+		genesisTime string
+		expErr      string
+		expStart    int64
+		expVesting  sdk.Coins
+	}{
+		{
+			name:       "without genesis time the schedule is kept",
+			expStart:   vestingAcc.StartTime,
+			expVesting: vestingAcc.OriginalVesting,
+		},
+		{
+			name:        "schedule is rebased on the genesis time",
+			genesisTime: genesisTime.Format(time.RFC3339),
+			expStart:    genesisTime.Unix(),
+			expVesting:  sdk.NewCoins(sdk.NewInt64Coin("stake", 500)),
+		},
+		{
+			name:        "invalid genesis time",
+			genesisTime: "tomorrow",
+			expErr:      "failed to unmarshal genesis time",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			appOpts := viper.New()
+			appOpts.Set(flags.FlagHome, t.TempDir())
+			if tc.genesisTime != "" {
+				appOpts.Set(genutilcli.FlagGenesisTime, tc.genesisTime)
+			}
+
+			exported, err := appExport(log.NewNopLogger(), db, nil, -1, true, nil, appOpts, nil)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+
+			var genState map[string]json.RawMessage
+			require.NoError(t, json.Unmarshal(exported.AppState, &genState))
+
+			var authGenState authtypes.GenesisState
+			require.NoError(t, app.AppCodec().UnmarshalJSON(genState[authtypes.ModuleName], &authGenState))
+			genAccs, err := authtypes.UnpackAccounts(authGenState.Accounts)
+			require.NoError(t, err)
+
+			var found bool
+			for _, acc := range genAccs {
+				if !acc.GetAddress().Equals(addr) {
+					continue
+				}
+
+				continuous, ok := acc.(*vestingtypes.ContinuousVestingAccount)
+				require.True(t, ok)
+				require.Equal(t, tc.expStart, continuous.StartTime)
+				require.Equal(t, vestingAcc.EndTime, continuous.EndTime)
+				require.Equal(t, tc.expVesting, continuous.OriginalVesting)
+				found = true
+			}
+			require.True(t, found)
+		})
+	}
+}
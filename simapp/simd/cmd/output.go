@@ -0,0 +1,151 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"unicode"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+)
+
+// withQueryOutput wraps the given query commands so that their responses are
+// rendered according to the output format of the client config or the --output
+// flag: json prints the response as indented JSON, text prints it as a flattened
+// key/value table.
+func withQueryOutput(cmds ...*cobra.Command) []*cobra.Command {
+	for _, cmd := range cmds {
+		if cmd.RunE == nil || cmd.Flags().Lookup(flags.FlagOutput) == nil {
+			continue
+		}
+
+		runE := cmd.RunE
+		cmd.RunE = func(cmd *cobra.Command, args []string) error {
+			// the output format from the client config is used unless the flag is set
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			format := clientCtx.OutputFormat
+			if format == "" || cmd.Flags().Changed(flags.FlagOutput) {
+				var err error
+				if format, err = cmd.Flags().GetString(flags.FlagOutput); err != nil {
+					return err
+				}
+			}
+
+			if format != flags.OutputFormatJSON && format != flags.OutputFormatText {
+				return fmt.Errorf("unsupported output format %q, expected %s or %s", format, flags.OutputFormatJSON, flags.OutputFormatText)
+			}
+
+			// let the command print JSON in a buffer, it is rendered afterwards
+			if err := cmd.Flags().Set(flags.FlagOutput, flags.OutputFormatJSON); err != nil {
+				return err
+			}
+
+			buf := &bytes.Buffer{}
+			clientCtx = clientCtx.WithOutputFormat(flags.OutputFormatJSON).WithOutput(buf)
+			if err := client.SetCmdClientContext(cmd, clientCtx); err != nil {
+				return err
+			}
+
+			if err := runE(cmd, args); err != nil {
+				return err
+			}
+
+			return renderQueryOutput(cmd.OutOrStdout(), format, buf.Bytes())
+		}
+	}
+
+	return cmds
+}
+
+// renderQueryOutput writes the JSON encoded query response bz to w in the given
+// output format.
+func renderQueryOutput(w io.Writer, format string, bz []byte) error {
+	bz = bytes.TrimSpace(bz)
+	if len(bz) == 0 {
+		return nil
+	}
+
+	if format == flags.OutputFormatJSON {
+		out := &bytes.Buffer{}
+		if err := json.Indent(out, bz, "", "  "); err != nil {
+			return err
+		}
+		out.WriteByte('\n')
+
+		_, err := w.Write(out.Bytes())
+		return err
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(bz))
+	dec.UseNumber()
+
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		return err
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	for _, row := range flattenJSON("", v, nil) {
+		if _, err := fmt.Fprintf(tw, "%s\t%s\n", row[0], row[1]); err != nil {
+			return err
+		}
+	}
+
+	return tw.Flush()
+}
+
+// flattenJSON appends to rows one key/value pair per leaf of the decoded JSON
+// value v. Nested objects are joined with dots and repeated fields are indexed,
+// e.g. "tx.body.messages[0].amount". Object keys are sorted so the output is
+// deterministic. Empty strings and strings containing control characters, such
+// as multi-line logs, are quoted.
+func flattenJSON(prefix string, v any, rows [][2]string) [][2]string {
+	switch v := v.(type) {
+	case map[string]any:
+		if len(v) == 0 {
+			return append(rows, [2]string{prefix, "{}"})
+		}
+
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		for _, k := range keys {
+			key := k
+			if prefix != "" {
+				key = prefix + "." + k
+			}
+			rows = flattenJSON(key, v[k], rows)
+		}
+	case []any:
+		if len(v) == 0 {
+			return append(rows, [2]string{prefix, "[]"})
+		}
+
+		for i, e := range v {
+			rows = flattenJSON(prefix+"["+strconv.Itoa(i)+"]", e, rows)
+		}
+	case nil:
+		rows = append(rows, [2]string{prefix, "null"})
+	case string:
+		// quote values that would otherwise be invisible or break the table rows
+		if v == "" || strings.ContainsFunc(v, unicode.IsControl) {
+			v = strconv.Quote(v)
+		}
+		rows = append(rows, [2]string{prefix, v})
+	default:
+		rows = append(rows, [2]string{prefix, fmt.Sprint(v)})
+	}
+
+	return rows
+}
@@ -0,0 +1,130 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+	"gotest.tools/v3/golden"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestQueryOutput(t *testing.T) {
+	res := &sdk.TxResponse{
+		Height:    42,
+		TxHash:    "C0FFEE",
+		RawLog:    "failed to execute message:\n\tinsufficient funds",
+		GasWanted: 200000,
+		GasUsed:   61234,
+		Events: []abci.Event{
+			{
+				Type: "transfer",
+				Attributes: []abci.EventAttribute{
+					{Key: "recipient", Value: "cosmos1recipient", Index: true},
+					{Key: "amount", Value: "10stake", Index: true},
+				},
+			},
+			{Type: "message"},
+		},
+	}
+
+	testCases := []struct {
+		name   string
+		format string
+		args   []string
+		golden string
+		expErr string
+	}{
+		{
+			name:   "default text output",
+			args:   []string{},
+			golden: "query-output-text.golden",
+		},
+		{
+			name:   "text output",
+			args:   []string{fmt.Sprintf("--%s=%s", flags.FlagOutput, flags.OutputFormatText)},
+			golden: "query-output-text.golden",
+		},
+		{
+			name:   "json output",
+			args:   []string{fmt.Sprintf("--%s=%s", flags.FlagOutput, flags.OutputFormatJSON)},
+			golden: "query-output-json.golden",
+		},
+		{
+			name:   "json output from client config",
+			format: flags.OutputFormatJSON,
+			args:   []string{},
+			golden: "query-output-json.golden",
+		},
+		{
+			name:   "flag overrides client config",
+			format: flags.OutputFormatJSON,
+			args:   []string{fmt.Sprintf("--%s=%s", flags.FlagOutput, flags.OutputFormatText)},
+			golden: "query-output-text.golden",
+		},
+		{
+			name:   "unsupported output",
+			args:   []string{fmt.Sprintf("--%s=yaml", flags.FlagOutput)},
+			expErr: "unsupported output format",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cmd := &cobra.Command{
+				Use: "sample",
+				RunE: func(cmd *cobra.Command, _ []string) error {
+					clientCtx, err := client.GetClientQueryContext(cmd)
+					if err != nil {
+						return err
+					}
+
+					return clientCtx.PrintProto(res)
+				},
+			}
+			flags.AddQueryFlagsToCmd(cmd)
+			withQueryOutput(cmd)
+
+			out := &bytes.Buffer{}
+			cmd.SetOut(out)
+			cmd.SetArgs(tc.args)
+
+			clientCtx := client.Context{}.
+				WithCodec(codectestutil.CodecOptions{}.NewCodec()).
+				WithOutputFormat(tc.format)
+			ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+
+			err := cmd.ExecuteContext(ctx)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+
+			require.NoError(t, err)
+			golden.Assert(t, out.String(), tc.golden)
+		})
+	}
+}
+
+func TestQueryCommandOutput(t *testing.T) {
+	wrapped := []string{"wait-tx", "block", "txs", "blocks", "tx", "block-results"}
+
+	cmd := queryCommand()
+	for _, name := range wrapped {
+		subCmd := findSubCommand(cmd, name)
+		require.NotNil(t, subCmd, name)
+
+		// the output format is checked by the wrapper before the command runs
+		subCmd.SetContext(context.WithValue(context.Background(), client.ClientContextKey, &client.Context{}))
+		require.NoError(t, subCmd.Flags().Set(flags.FlagOutput, "yaml"))
+		require.ErrorContains(t, subCmd.RunE(subCmd, nil), "unsupported output format", name)
+	}
+}
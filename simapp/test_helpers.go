@@ -152,6 +152,28 @@ func SetupWithGenesisValSet(t *testing.T, valSet *cmttypes.ValidatorSet, genAccs
 	return app
 }
 
+// SetupWithSimGenesisAccounts initializes a new SimApp with a validator set and
+// the accounts described by the given simulation genesis accounts, each funded
+// with its Coins. The first account delegates to the validators, see
+// SetupWithGenesisValSet.
+func SetupWithSimGenesisAccounts(t *testing.T, valSet *cmttypes.ValidatorSet, simAccs ...SimGenesisAccount) *SimApp {
+	t.Helper()
+
+	genAccs := make([]authtypes.GenesisAccount, 0, len(simAccs))
+	balances := make([]banktypes.Balance, 0, len(simAccs))
+	for _, simAcc := range simAccs {
+		genAcc, err := simAcc.ToGenesisAccount()
+		require.NoError(t, err)
+		genAccs = append(genAccs, genAcc)
+
+		if !simAcc.Coins.IsZero() {
+			balances = append(balances, banktypes.Balance{Address: genAcc.GetAddress().String(), Coins: simAcc.Coins})
+		}
+	}
+
+	return SetupWithGenesisValSet(t, valSet, genAccs, balances...)
+}
+
 // GenesisStateWithSingleValidator initializes GenesisState with a single validator and genesis accounts
 // that also act as delegators.
 func GenesisStateWithSingleValidator(t *testing.T, app *SimApp) GenesisState {
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -77,6 +78,13 @@ func ExportCmd(appExporter servertypes.AppExporter) *cobra.Command {
 			modulesToExport, _ := cmd.Flags().GetStringSlice(flagModulesToExport)
 			outputDocument, _ := cmd.Flags().GetString(flags.FlagOutputDocument)
 
+			var genesisTime time.Time
+			if genesisTimeStr, _ := cmd.Flags().GetString(FlagGenesisTime); genesisTimeStr != "" {
+				if err := genesisTime.UnmarshalText([]byte(genesisTimeStr)); err != nil {
+					return fmt.Errorf("failed to unmarshal genesis time: %w", err)
+				}
+			}
+
 			exported, err := appExporter(serverCtx.Logger, db, traceWriter, height, forZeroHeight, jailAllowedAddrs, serverCtx.Viper, modulesToExport)
 			if err != nil {
 				return fmt.Errorf("error exporting state: %w", err)
@@ -91,6 +99,10 @@ func ExportCmd(appExporter servertypes.AppExporter) *cobra.Command {
 			appGenesis.AppName = version.AppName
 			appGenesis.AppVersion = version.Version
 
+			if !genesisTime.IsZero() {
+				appGenesis.GenesisTime = genesisTime
+			}
+
 			appGenesis.AppState = exported.AppState
 			appGenesis.InitialHeight = exported.Height
 			appGenesis.Consensus = genutiltypes.NewConsensusGenesis(exported.ConsensusParams, exported.Validators)
@@ -118,6 +130,7 @@ func ExportCmd(appExporter servertypes.AppExporter) *cobra.Command {
 	cmd.Flags().Bool(flagForZeroHeight, false, "Export state to start at height zero (perform preproccessing)")
 	cmd.Flags().StringSlice(flagJailAllowedAddrs, []string{}, "Comma-separated list of operator addresses of jailed validators to unjail")
 	cmd.Flags().StringSlice(flagModulesToExport, []string{}, "Comma-separated list of modules to export. If empty, will export all modules")
+	cmd.Flags().String(FlagGenesisTime, "", "Override genesis_time of the exported genesis (RFC3339), the app may rebase time-dependent state on it")
 	cmd.Flags().String(flags.FlagOutputDocument, "", "Exported state is written to the given file instead of STDOUT")
 
 	return cmd
@@ -288,6 +288,38 @@ func TestExportCLI(t *testing.T) {
 		CheckExportedGenesis(t, j)
 	})
 
+	t.Run("overrides the genesis time with --genesis-time", func(t *testing.T) {
+		t.Parallel()
+
+		e := new(mockExporter)
+		e.SetDefaultExportApp()
+
+		sys := NewExportSystem(t, e.Export)
+		_ = sys.MustRun(t, "init", "some_moniker")
+		res := sys.MustRun(t, "export", "--genesis-time", "2023-11-14T22:13:20Z")
+
+		CheckExportedGenesis(t, res.Stdout.Bytes())
+
+		var ag genutiltypes.AppGenesis
+		require.NoError(t, json.Unmarshal(res.Stdout.Bytes(), &ag))
+		require.Equal(t, time.Unix(1700000000, 0).UTC(), ag.GenesisTime)
+	})
+
+	t.Run("rejects an invalid --genesis-time", func(t *testing.T) {
+		t.Parallel()
+
+		e := new(mockExporter)
+		e.SetDefaultExportApp()
+
+		sys := NewExportSystem(t, e.Export)
+		_ = sys.MustRun(t, "init", "some_moniker")
+
+		res := sys.Run("export", "--genesis-time", "tomorrow")
+		require.ErrorContains(t, res.Err, "failed to unmarshal genesis time")
+
+		require.False(t, e.WasCalled)
+	})
+
 	t.Run("prints genesis to stdout when no app exporter defined", func(t *testing.T) {
 		t.Parallel()
 
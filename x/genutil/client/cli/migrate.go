@@ -16,7 +16,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/genutil/types"
 )
 
-const flagGenesisTime = "genesis-time"
+// FlagGenesisTime defines a flag to override the genesis time of the genesis file.
+const FlagGenesisTime = "genesis-time"
 
 // MigrationMap is a map of SDK versions to their respective genesis migration functions.
 var MigrationMap = types.MigrationMap{}
@@ -36,7 +37,7 @@ func MigrateGenesisCmd(migrations types.MigrationMap) *cobra.Command {
 		},
 	}
 
-	cmd.Flags().String(flagGenesisTime, "", "Override genesis_time with this flag")
+	cmd.Flags().String(FlagGenesisTime, "", "Override genesis_time with this flag")
 	cmd.Flags().String(flags.FlagChainID, "", "Override chain_id with this flag")
 	cmd.Flags().String(flags.FlagOutputDocument, "", "Exported state is written to the given file instead of STDOUT")
 
@@ -89,7 +90,7 @@ func MigrateHandler(cmd *cobra.Command, args []string, migrations types.Migratio
 		return fmt.Errorf("failed to JSON marshal migrated genesis state: %w", err)
 	}
 
-	genesisTime, _ := cmd.Flags().GetString(flagGenesisTime)
+	genesisTime, _ := cmd.Flags().GetString(FlagGenesisTime)
 	if genesisTime != "" {
 		var t time.Time
 